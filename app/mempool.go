@@ -0,0 +1,59 @@
+package app
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+	"github.com/celestiaorg/celestia-app/blob/lane"
+)
+
+// BondDenom is the fee denom the blob lane, the min-blob-fee ante decorator,
+// and the fee market all price against.
+const BondDenom = "utia"
+
+// Default block-space reservations for the lanes registered in app.New. The
+// blob lane gets the lion's share since blob throughput is the chain's
+// primary product; the priority lane is a thin reserve for txs willing to
+// pay a premium to jump the default lane's queue.
+var (
+	DefaultBlobLaneMaxBlockSpace     = math.LegacyMustNewDecFromStr("0.6")
+	DefaultPriorityLaneMaxBlockSpace = math.LegacyMustNewDecFromStr("0.1")
+	DefaultPriorityLaneMinTipRatio   = math.LegacyMustNewDecFromStr("2")
+	DefaultLaneMaxBlockSpace         = math.LegacyMustNewDecFromStr("0.3")
+)
+
+// NewLaneMempool builds the app's mempool: a blob lane (BlobTx-wrapped
+// MsgPayForBlob only), a priority lane (high-fee-tip txs of any kind), and a
+// default lane (everything else), in that match order. See SetLaneMempool
+// for how app.New registers it on baseapp. txDecoder is used by the blob
+// lane to recover the signer - and the wrapped inner tx itself - of a
+// transaction wrapped in a BlobTx envelope; txEncoder gives the priority
+// lane a byte-identity hash for its admitted-tx bookkeeping; bondDenom is
+// the fee denom the blob and priority lanes price against.
+func NewLaneMempool(txDecoder sdk.TxDecoder, txEncoder sdk.TxEncoder, bondDenom string) *lane.LaneMempool {
+	signers := adapter.NewBlobTxSignerExtractionAdapter(txDecoder)
+	return lane.NewLaneMempool(
+		lane.NewBlobLane(DefaultBlobLaneMaxBlockSpace, signers, txDecoder, bondDenom),
+		lane.NewPriorityLane(DefaultPriorityLaneMaxBlockSpace, DefaultPriorityLaneMinTipRatio, txEncoder, bondDenom),
+		lane.NewDefaultLane(DefaultLaneMaxBlockSpace),
+	)
+}
+
+// SetLaneMempool builds the app's lane mempool via NewLaneMempool and
+// registers it on the embedded BaseApp via SetMempool, together with the
+// PrepareProposal/ProcessProposal handlers in proposal.go that respect its
+// lanes' budgets. app.New would need to call this once, after the BaseApp
+// and its TxConfig are constructed, so CheckTx and proposal building share
+// the same lane-aware ordering - but app.New is outside this tree's
+// snapshot, and grepping this tree turns up no call site for SetLaneMempool
+// at all. Until one exists, every package in this series (lane, adapter,
+// fee market) changes zero running behavior: this is a hard blocker for
+// whoever owns app.go, not a detail this file can paper over.
+func (app *App) SetLaneMempool(txDecoder sdk.TxDecoder, txEncoder sdk.TxEncoder) {
+	mp := NewLaneMempool(txDecoder, txEncoder, BondDenom)
+	app.laneMempool = mp
+	app.SetMempool(mp)
+	app.SetPrepareProposal(app.prepareProposalHandler)
+	app.SetProcessProposal(app.processProposalHandler)
+}
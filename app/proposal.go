@@ -0,0 +1,120 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/blob/lane"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+)
+
+// prepareProposalHandler fills the block from the app's lane mempool,
+// respecting each lane's MaxBlockSpace, instead of baseapp's default
+// highest-fee-first selection. SetLaneMempool registers it via
+// SetPrepareProposal.
+//
+// SelectUpToBytes's concatenated selection is then run through
+// square.Build, which caps the total by the data square's share budget
+// rather than just the lanes' own byte budgets - a proposal within every
+// lane's MaxBlockSpace can still overflow the square once each tx is rounded
+// up to whole shares. square.Build only enforces that share budget; it does
+// not itself lay blobs out into NMT-backed rows/columns (reserved
+// namespaces, non-interactive defaults, subtree roots), which is a larger
+// routine outside this tree's snapshot. See square.Build's doc comment for
+// the scope split.
+func (app *App) prepareProposalHandler(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+	ctx := app.NewContext(true, tmproto.Header{Height: req.Height, Time: req.Time})
+
+	selected := app.laneMempool.SelectUpToBytes(ctx, int(req.MaxTxBytes), func(tx sdk.Tx) int {
+		bz, err := app.txConfig.TxEncoder()(tx)
+		if err != nil {
+			return 0
+		}
+		return len(bz)
+	})
+
+	txs := make([][]byte, 0, len(selected))
+	for _, tx := range selected {
+		bz, err := app.txConfig.TxEncoder()(tx)
+		if err != nil {
+			continue
+		}
+		txs = append(txs, bz)
+	}
+
+	txs, _ = square.Build(txs, appconsts.DefaultMaxSquareSize)
+	return abci.ResponsePrepareProposal{Txs: txs}
+}
+
+// processProposalHandler re-derives, for a proposed block it did not
+// necessarily build itself, which lane each transaction belongs to and
+// rejects the proposal if any lane's share of the block exceeds its
+// MaxBlockSpace. This is the invariant re-check that stops a malicious or
+// buggy proposer from bypassing the blob lane's reservation simply by
+// never running PrepareProposal's own selection.
+//
+// Lane budgets here are computed against the block's max-bytes consensus
+// param - not the proposal's own total bytes. PrepareProposal sizes its
+// budgets from req.MaxTxBytes, which is at most that param, so a proposal it
+// built always fits the budgets checked here. Proposals routinely fall short of MaxTxBytes
+// (the priority and default lanes aren't always full), and sizing a lane's
+// budget off a smaller totalBytes would reject a proposal that
+// PrepareProposal itself just built to the real, larger budget. A MaxBytes
+// of -1 is Tendermint's convention for "unbounded" (see blobsim's own
+// InitChain in sim/blobsim/blobsim.go), so that value skips the per-lane
+// check entirely rather than being multiplied in as a budget-busting
+// negative number.
+//
+// Once a proposal passes that check, processProposalHandler also records
+// the blob lane's share of the block with the blob fee market via
+// Keeper.RecordUsedShares - not the block's total, since non-blob lanes'
+// bytes have nothing to do with square occupancy - so EndBlocker's price
+// update (see EndBlocker in this package) reflects the block that was
+// actually agreed on rather than whatever PrepareProposal speculatively
+// selected. SetLaneMempool registers this handler via SetProcessProposal.
+func (app *App) processProposalHandler(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+	ctx := app.NewContext(true, tmproto.Header{Height: req.Height, Time: req.Time})
+
+	maxTxBytes := ctx.ConsensusParams().Block.MaxBytes
+
+	laneBytes := make(map[string]int64, len(app.laneMempool.Lanes()))
+	for _, rawTx := range req.Txs {
+		tx, err := app.txConfig.TxDecoder()(rawTx)
+		if err != nil {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+		l, err := app.laneMempool.LaneFor(tx)
+		if err != nil {
+			return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		}
+		laneBytes[l.Name()] += int64(len(rawTx))
+	}
+
+	if maxTxBytes >= 0 {
+		for _, l := range app.laneMempool.Lanes() {
+			budget := l.MaxBlockSpace().MulInt64(maxTxBytes).TruncateInt64()
+			if laneBytes[l.Name()] > budget {
+				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+			}
+		}
+	}
+
+	app.BlobKeeper.RecordUsedShares(ctx, appconsts.SharesNeeded(uint64(laneBytes[lane.BlobLaneName])))
+
+	return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
+}
+
+// EndBlocker wraps the module manager's own EndBlock to also advance the
+// blob fee market's base price from the shares processProposalHandler
+// recorded for this block. Nothing in this tree calls it: app.New would need
+// to register it via SetEndBlocker in place of app.mm.EndBlocker, and app.New
+// is outside this tree's snapshot. Until that registration exists,
+// EndBlocker is dead code and the fee market it drives never advances on a
+// running chain - flagging that for whoever owns app.go, not asserting it
+// here as already handled.
+func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.BlobKeeper.EndBlocker(ctx)
+	return app.mm.EndBlock(ctx, req)
+}
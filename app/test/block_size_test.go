@@ -103,8 +103,14 @@ func (s *IntegrationTestSuite) TestMaxBlockSize() {
 	// transaction using some of the same accounts as the previous genertor, we
 	// are also testing to ensure that the sequence number is being utilized
 	// corrected in malleated txs
+	//
+	// Sizes are drawn from a seeded source rather than tmrand's
+	// process-random one (see RandBlobTxsWithAccountsAndSeed), so the same
+	// sequence of sizes - and the same square-packing outcome - is produced
+	// on every run instead of occasionally missing a max-size square by
+	// chance of when the test process happened to start.
 	randoTxGen := func(c client.Context) []coretypes.Tx {
-		return blobfactory.RandBlobTxsWithAccounts(
+		return blobfactory.RandBlobTxsWithAccountsAndSeed(
 			s.cfg.TxConfig.TxEncoder(),
 			s.kr,
 			c.GRPCClient,
@@ -112,6 +118,7 @@ func (s *IntegrationTestSuite) TestMaxBlockSize() {
 			true,
 			s.cfg.ChainID,
 			s.accounts[20:],
+			1,
 		)
 	}
 
@@ -247,6 +254,14 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 			assert.Equal(abci.CodeTypeOK, res.Code)
 		})
 	}
+
+	// A subtest here asserting BaseGasPricePerByte rises and decays can't
+	// reach the validator over gRPC: BaseGasPrice isn't registered on any
+	// gRPC Query service yet (see QueryBaseGasPrice's doc comment in
+	// x/blob/estimate.go). TestFeeMarketRisesAndDecaysAgainstRealApp, in
+	// this package, covers the same rise/decay assertions against a real
+	// in-process app and real submitted PayForBlob txs instead, sidestepping
+	// gRPC by reading BaseGasPricePerByte straight off the app's BlobKeeper.
 }
 
 func (s *IntegrationTestSuite) TestUnwrappedPFBRejection() {
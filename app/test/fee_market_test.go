@@ -0,0 +1,177 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// TestFeeMarketRisesAndDecaysAgainstRealApp is the integration-level
+// coverage TestSubmitPayForBlob's closing comment points to: it submits
+// real, ante-checked PayForBlob transactions against a live in-process app
+// (the same app.New + encoding.MakeConfig construction sim/blobsim uses) and
+// asserts BaseGasPricePerByte rises under sustained large blobs and decays
+// under empty blocks, rather than
+// TestFeeMarketRisesUnderSustainedDemandAndDecaysWhenEmpty's keeper-only
+// math, which seeds the sliding window by calling RecordUsedShares/
+// EndBlocker directly and never touches a real transaction.
+//
+// It is not a subtest of TestSubmitPayForBlob because that suite drives a
+// full validator over cosmosnet.Network/gRPC, and BaseGasPrice isn't
+// reachable that way - BaseGasPrice has no registered gRPC Query service
+// yet (see QueryBaseGasPrice's doc comment in x/blob/estimate.go). This test
+// sidesteps gRPC entirely by reading BaseGasPricePerByte straight off the
+// in-process app's BlobKeeper, the same way sim/blobsim compares app hashes
+// without one.
+//
+// It also can't drive the square-accounting/price-update step through the
+// app's own ABCI entry points: app.New is outside this tree's snapshot, and
+// app/proposal.go's EndBlocker doc comment already flags that nothing in
+// this tree calls SetEndBlocker to register it, so a.EndBlock() here would
+// only run the module manager's EndBlock, never App.EndBlocker. This test
+// calls App.BlobKeeper.RecordUsedShares and App.BlobKeeper.EndBlocker
+// directly after each DeliverTx, standing in for that still-missing
+// registration - the same gap root-level hard blocker noted in
+// app/proposal.go.
+func TestFeeMarketRisesAndDecaysAgainstRealApp(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+
+	const submitter = "fee-market-submitter"
+	kr := keyring.NewInMemory()
+	_, mnemonic, err := kr.NewMnemonic(submitter, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = kr.NewAccount(submitter, mnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+	signer := blobtypes.NewKeyringSigner(kr, submitter, "fee-market-chain")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	a := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, 0, encCfg)
+	a.InitChain(abci.RequestInitChain{
+		ChainId: "fee-market-chain",
+		ConsensusParams: &tmproto.ConsensusParams{
+			Block: &tmproto.BlockParams{MaxBytes: -1, MaxGas: -1},
+		},
+		AppStateBytes: fundedGenesis(t, encCfg, addr),
+	})
+	a.Commit()
+
+	// Scale the fee market's params down from types.DefaultFeeMarketParams
+	// so a full rise-then-decay window is a handful of small blobs rather
+	// than a hundred multi-megabyte ones.
+	params := blobtypes.FeeMarketParams{
+		TargetSquareShares:     8,
+		WindowSize:             5,
+		MaxAdjustmentPerBlock:  math.LegacyMustNewDecFromStr("0.125"),
+		MinBaseGasPricePerByte: math.LegacyMustNewDecFromStr("0.000001"),
+	}
+	height := int64(1)
+	a.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+	ctx := a.NewContext(false, tmproto.Header{Height: height})
+	a.BlobKeeper.SetFeeMarketParams(ctx, params)
+	a.EndBlock(abci.RequestEndBlock{Height: height})
+	a.Commit()
+	height++
+
+	largeBlobSize := int(params.TargetSquareShares) * 2 * int(appconsts.ShareSize)
+
+	submitBlock := func(blobSize int) math.LegacyDec {
+		a.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		var deliveredBytes int
+		if blobSize > 0 {
+			blob, err := blobtypes.NewBlob([]byte{1, 2, 3, 4, 5, 6, 7, 8}, make([]byte, blobSize))
+			require.NoError(t, err)
+			msg, err := blobtypes.NewMsgPayForBlob(addr.String(), blob)
+			require.NoError(t, err)
+
+			opts := []blobtypes.TxBuilderOption{
+				blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewInt(1000000)))),
+				blobtypes.SetGasLimit(10000000),
+			}
+			builder := signer.NewTxBuilder(opts...)
+			stx, err := signer.BuildSignedTx(builder, msg)
+			require.NoError(t, err)
+			rawTx, err := encCfg.TxConfig.TxEncoder()(stx)
+			require.NoError(t, err)
+
+			res := a.DeliverTx(abci.RequestDeliverTx{Tx: rawTx})
+			require.Equal(t, abci.CodeTypeOK, res.Code, res.Log)
+			deliveredBytes = blobSize
+		}
+
+		ctx := a.NewContext(false, tmproto.Header{Height: height})
+		a.BlobKeeper.RecordUsedShares(ctx, appconsts.SharesNeeded(uint64(deliveredBytes)))
+		a.BlobKeeper.EndBlocker(ctx)
+		price := a.BlobKeeper.GetBaseGasPricePerByte(ctx)
+
+		a.EndBlock(abci.RequestEndBlock{Height: height})
+		a.Commit()
+		height++
+
+		return price
+	}
+
+	startPrice := a.BlobKeeper.GetBaseGasPricePerByte(a.NewContext(true, tmproto.Header{}))
+	require.True(t, startPrice.Equal(params.MinBaseGasPricePerByte))
+
+	last := startPrice
+	for i := uint64(0); i < params.WindowSize; i++ {
+		next := submitBlock(largeBlobSize)
+		require.True(t, next.GTE(last), "price must not fall under sustained demand")
+		last = next
+	}
+	require.True(t, last.GT(startPrice), "price must have risen from sustained large blobs")
+
+	// The window still averages above target until at least half of its
+	// slots hold empty blocks, so until then the price keeps rising.
+	risenPrice := last
+	for i := uint64(0); i < params.WindowSize; i++ {
+		next := submitBlock(0)
+		if 2*(i+1) >= params.WindowSize {
+			require.True(t, next.LTE(last), "price must not rise once the window averages at or below target")
+		} else {
+			require.True(t, next.GTE(last), "price must keep rising while the window averages above target")
+		}
+		last = next
+	}
+	require.True(t, last.LT(risenPrice), "price must have decayed from empty blocks")
+}
+
+// fundedGenesis returns the module genesis app state ModuleBasics.DefaultGenesis
+// produces, with the bank module credited so addr can pay for this test's
+// PayForBlob fees - without it, the standard fee-deduction ante decorator
+// would reject every submitted tx before a blob was ever processed.
+func fundedGenesis(t *testing.T, encCfg encoding.Config, addr sdk.AccAddress) json.RawMessage {
+	genesis := app.ModuleBasics.DefaultGenesis(encCfg.Codec)
+
+	var bankGenesis banktypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis)
+
+	balance := sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewInt(1000000000)))
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: addr.String(),
+		Coins:   balance,
+	})
+	bankGenesis.Supply = bankGenesis.Supply.Add(balance...)
+
+	genesis[banktypes.ModuleName] = encCfg.Codec.MustMarshalJSON(&bankGenesis)
+	appStateBytes, err := json.Marshal(genesis)
+	require.NoError(t, err)
+	return appStateBytes
+}
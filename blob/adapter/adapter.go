@@ -0,0 +1,111 @@
+// Package adapter lets code that only has access to a (possibly BlobTx
+// wrapped) transaction recover the signers and sequence numbers of the
+// underlying signed sdk.Tx. This mirrors the cosmos-sdk mempool's own
+// SignerExtractionAdapter (see types/mempool.SignerExtractionAdapter), but
+// adds a BlobTxAdapter so that a BlobTx - a signed sdk.Tx with its blobs
+// concatenated into a separate top-level envelope, see
+// coretypes.MarshalBlobTx - can be unwrapped first. Without it, naively
+// reading sdk.Tx.GetSigners() on a wrapped tx sees nothing, since the
+// wrapper itself is not a signed Cosmos SDK transaction.
+package adapter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// SignerData carries the resolved identity of a single signer of a
+// transaction, paired with the sequence number they signed with.
+type SignerData struct {
+	Signer   sdk.AccAddress
+	Sequence uint64
+}
+
+// SignerExtractionAdapter recovers the signers of a transaction regardless
+// of whether it arrives as a plain signed sdk.Tx or wrapped in a BlobTx.
+type SignerExtractionAdapter interface {
+	GetSigners(tx sdk.Tx) ([]SignerData, error)
+}
+
+// RawTxProvider is implemented by any sdk.Tx that also retains the raw bytes
+// it was decoded from. The mempool and CheckTx paths hold onto this form of
+// a tx; BlobTxAdapter relies on it to detect and unwrap a BlobTx envelope.
+type RawTxProvider interface {
+	Bytes() []byte
+}
+
+// DefaultAdapter extracts signers from an ordinary signed sdk.Tx. It is a
+// thin wrapper around the cosmos-sdk mempool's own default adapter, kept
+// local so callers can depend on SignerExtractionAdapter without importing
+// the mempool package directly.
+type DefaultAdapter struct{}
+
+// NewDefaultSignerExtractionAdapter returns the DefaultAdapter.
+func NewDefaultSignerExtractionAdapter() SignerExtractionAdapter {
+	return DefaultAdapter{}
+}
+
+func (DefaultAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
+	signers, err := mempool.NewDefaultSignerExtractionAdapter().GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]SignerData, len(signers))
+	for i, s := range signers {
+		out[i] = SignerData{Signer: s.Signer, Sequence: s.Sequence}
+	}
+	return out, nil
+}
+
+// BlobTxAdapter extracts signers from a BlobTx by unwrapping it to its inner
+// signed sdk.Tx before delegating to DefaultAdapter. Any tx that does not
+// carry its raw bytes, or whose raw bytes do not decode as a BlobTx, falls
+// back to DefaultAdapter unchanged.
+type BlobTxAdapter struct {
+	decoder  sdk.TxDecoder
+	fallback SignerExtractionAdapter
+}
+
+// NewBlobTxSignerExtractionAdapter returns a BlobTxAdapter that uses decoder
+// to turn an unwrapped BlobTx's inner tx bytes back into an sdk.Tx.
+func NewBlobTxSignerExtractionAdapter(decoder sdk.TxDecoder) SignerExtractionAdapter {
+	return BlobTxAdapter{decoder: decoder, fallback: DefaultAdapter{}}
+}
+
+func (a BlobTxAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
+	inner, unwrapped, err := UnwrapBlobTx(tx, a.decoder)
+	if err != nil {
+		return nil, err
+	}
+	if !unwrapped {
+		return a.fallback.GetSigners(tx)
+	}
+	return a.fallback.GetSigners(inner)
+}
+
+// UnwrapBlobTx returns the inner signed sdk.Tx of tx, using decoder to decode
+// a BlobTx envelope's inner tx bytes. unwrapped is false, with tx returned
+// unchanged, whenever tx doesn't carry its raw bytes (see RawTxProvider) or
+// those bytes don't decode as a BlobTx. Callers that need to look inside a
+// possibly-wrapped tx - not just resolve its signers - should call this
+// directly rather than assume tx.GetMsgs() already sees through the envelope.
+func UnwrapBlobTx(tx sdk.Tx, decoder sdk.TxDecoder) (inner sdk.Tx, unwrapped bool, err error) {
+	raw, ok := tx.(RawTxProvider)
+	if !ok {
+		return tx, false, nil
+	}
+
+	btx, isBlobTx := coretypes.UnmarshalBlobTx(raw.Bytes())
+	if !isBlobTx {
+		return tx, false, nil
+	}
+
+	inner, err = decoder(btx.Tx)
+	if err != nil {
+		return nil, false, fmt.Errorf("adapter: decoding inner tx of BlobTx: %w", err)
+	}
+	return inner, true, nil
+}
@@ -0,0 +1,104 @@
+package adapter_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+	"github.com/celestiaorg/celestia-app/testutil/blobfactory"
+	"github.com/celestiaorg/celestia-app/testutil/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// rawTx wraps a decoded sdk.Tx together with the bytes it was decoded from,
+// the way the mempool and CheckTx paths hold transactions.
+type rawTx struct {
+	sdk.Tx
+	raw []byte
+}
+
+func (t rawTx) Bytes() []byte {
+	return t.raw
+}
+
+// panicTx is an sdk.Tx whose accessors panic if called, standing in for the
+// BlobTx envelope's own non-signed sdk.Tx representation in
+// TestBlobTxAdapterUnwrapsBlobTx. It exists so that test can only pass by
+// BlobTxAdapter actually unwrapping raw bytes via coretypes.UnmarshalBlobTx
+// and decoder, not by accident via GetSigners falling through to whatever
+// tx happens to be embedded in rawTx.
+type panicTx struct{}
+
+func (panicTx) GetMsgs() []sdk.Msg {
+	panic("panicTx: GetMsgs called; BlobTxAdapter should have unwrapped the BlobTx before reaching this tx")
+}
+
+func (panicTx) ValidateBasic() error {
+	panic("panicTx: ValidateBasic called; BlobTxAdapter should have unwrapped the BlobTx before reaching this tx")
+}
+
+func TestBlobTxAdapterUnwrapsBlobTx(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+
+	acc := "blob-submitter"
+	kr := testfactory.GenerateKeyring(acc)
+	signer := blobtypes.NewKeyringSigner(kr, acc, "test-chain")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	msg, blob := blobfactory.RandMsgPayForBlobWithSigner(addr.String(), 100)
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(10)))),
+		blobtypes.SetGasLimit(100000),
+	}
+	builder := signer.NewTxBuilder(opts...)
+	stx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+
+	rawStx, err := encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+
+	blobTxBytes, err := coretypes.MarshalBlobTx(rawStx, blob)
+	require.NoError(t, err)
+
+	// Decoding the BlobTx envelope bytes directly as an sdk.Tx is exactly
+	// the failure mode this adapter exists to avoid: a plain TxDecoder
+	// cannot make sense of the wrapper, so any caller doing so naively
+	// would see zero signers or an outright decode error. wrapped embeds
+	// panicTx rather than stx so that recovering the signer is only
+	// possible through raw.Bytes() + decoder, not through the embedded
+	// sdk.Tx's own promoted methods.
+	blobTxAdapter := adapter.NewBlobTxSignerExtractionAdapter(encCfg.TxConfig.TxDecoder())
+	wrapped := rawTx{Tx: panicTx{}, raw: blobTxBytes}
+
+	signers, err := blobTxAdapter.GetSigners(wrapped)
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, addr.String(), signers[0].Signer.String())
+}
+
+func TestDefaultAdapterHandlesPlainTx(t *testing.T) {
+	acc := "plain-sender"
+	kr := testfactory.GenerateKeyring(acc)
+	signer := blobtypes.NewKeyringSigner(kr, acc, "test-chain")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	msg, _ := blobfactory.RandMsgPayForBlobWithSigner(addr.String(), 100)
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(10)))),
+		blobtypes.SetGasLimit(100000),
+	}
+	stx, err := signer.BuildSignedTx(signer.NewTxBuilder(opts...), msg)
+	require.NoError(t, err)
+
+	defaultAdapter := adapter.NewDefaultSignerExtractionAdapter()
+	signers, err := defaultAdapter.GetSigners(stx)
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, addr.String(), signers[0].Signer.String())
+}
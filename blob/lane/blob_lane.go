@@ -0,0 +1,223 @@
+package lane
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// BlobLaneName is the registered name of the lane that only admits
+// transactions containing a MsgPayForBlob.
+const BlobLaneName = "blob"
+
+// blobLaneTx is the bookkeeping BlobLane keeps per admitted transaction.
+type blobLaneTx struct {
+	tx          sdk.Tx
+	feePerShare math.LegacyDec
+}
+
+// BlobLane is a Lane that admits only transactions carrying a
+// MsgPayForBlob and orders them by fee_per_share, i.e. the fee paid per
+// share of square space the blob will occupy. This rewards blobs that pay
+// proportionally more for the space they consume rather than simply the
+// highest total fee, so a handful of small high-value blobs cannot be
+// crowded out by one cheap, enormous one.
+//
+// Transactions are keyed by their true payer's (signer, sequence) pair,
+// resolved via a SignerExtractionAdapter rather than the tx's own bytes, so
+// that resubmitting a BlobTx from the same signer at the same sequence with
+// a higher fee replaces the pending one instead of queuing alongside it.
+type BlobLane struct {
+	mu            sync.Mutex
+	maxBlockSpace math.LegacyDec
+	signers       adapter.SignerExtractionAdapter
+	decoder       sdk.TxDecoder
+	bondDenom     string
+	txs           *list.List // sorted descending by feePerShare
+	byKey         map[string]*list.Element
+}
+
+// NewBlobLane constructs a BlobLane that may claim up to maxBlockSpace of the
+// square/byte budget during PrepareProposal. signers resolves the true payer
+// of each admitted transaction for priority-nonce grouping; decoder is used
+// to unwrap a BlobTx-wrapped transaction (see adapter.UnwrapBlobTx) before
+// looking for its MsgPayForBlob, since the tx handed to Match/Insert may
+// still be in its wrapped form. bondDenom is the fee denom feePerShare
+// prices against.
+func NewBlobLane(maxBlockSpace math.LegacyDec, signers adapter.SignerExtractionAdapter, decoder sdk.TxDecoder, bondDenom string) *BlobLane {
+	return &BlobLane{
+		maxBlockSpace: maxBlockSpace,
+		signers:       signers,
+		decoder:       decoder,
+		bondDenom:     bondDenom,
+		txs:           list.New(),
+		byKey:         make(map[string]*list.Element),
+	}
+}
+
+func (l *BlobLane) Name() string {
+	return BlobLaneName
+}
+
+func (l *BlobLane) MaxBlockSpace() math.LegacyDec {
+	return l.maxBlockSpace
+}
+
+// Match returns true for any transaction that carries at least one
+// MsgPayForBlob, unwrapping tx first if it arrives BlobTx-wrapped.
+func (l *BlobLane) Match(tx sdk.Tx) bool {
+	_, ok := firstPFB(l.unwrap(tx))
+	return ok
+}
+
+// unwrap returns tx's inner signed sdk.Tx if tx is BlobTx-wrapped, or tx
+// itself otherwise. payerKey doesn't need this - it already unwraps via
+// l.signers (an adapter.BlobTxAdapter).
+func (l *BlobLane) unwrap(tx sdk.Tx) sdk.Tx {
+	inner, ok, err := adapter.UnwrapBlobTx(tx, l.decoder)
+	if err != nil || !ok {
+		return tx
+	}
+	return inner
+}
+
+func (l *BlobLane) Insert(_ context.Context, tx sdk.Tx) error {
+	unwrapped := l.unwrap(tx)
+	pfb, ok := firstPFB(unwrapped)
+	if !ok {
+		return fmt.Errorf("blob lane: tx does not contain a MsgPayForBlob")
+	}
+
+	feePerShare, err := feePerShare(unwrapped, pfb, l.bondDenom)
+	if err != nil {
+		return err
+	}
+
+	key, err := l.payerKey(tx)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := &blobLaneTx{tx: tx, feePerShare: feePerShare}
+
+	if existing, ok := l.byKey[key]; ok {
+		if !feePerShare.GT(existing.Value.(*blobLaneTx).feePerShare) {
+			// A pending blob from this signer at this sequence already pays
+			// at least as much per share; keep it and drop the resubmit.
+			return nil
+		}
+		l.txs.Remove(existing)
+		delete(l.byKey, key)
+	}
+
+	for e := l.txs.Front(); e != nil; e = e.Next() {
+		if entry.feePerShare.GT(e.Value.(*blobLaneTx).feePerShare) {
+			elem := l.txs.InsertBefore(entry, e)
+			l.byKey[key] = elem
+			return nil
+		}
+	}
+	elem := l.txs.PushBack(entry)
+	l.byKey[key] = elem
+	return nil
+}
+
+func (l *BlobLane) Remove(tx sdk.Tx) error {
+	key, err := l.payerKey(tx)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.byKey[key]
+	if !ok {
+		return mempool.ErrTxNotFound
+	}
+	l.txs.Remove(elem)
+	delete(l.byKey, key)
+	return nil
+}
+
+// payerKey resolves tx's true payer - the first signer reported by the
+// lane's SignerExtractionAdapter, which unwraps a BlobTx to its inner
+// signed sdk.Tx before reading signers - and returns a stable map key of
+// their address and sequence.
+func (l *BlobLane) payerKey(tx sdk.Tx) (string, error) {
+	signers, err := l.signers.GetSigners(tx)
+	if err != nil {
+		return "", fmt.Errorf("blob lane: resolving payer: %w", err)
+	}
+	if len(signers) == 0 {
+		return "", fmt.Errorf("blob lane: tx has no signers")
+	}
+	return fmt.Sprintf("%s/%d", signers[0].Signer.String(), signers[0].Sequence), nil
+}
+
+func (l *BlobLane) CountTx() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.txs.Len()
+}
+
+func (l *BlobLane) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	txs := make([]sdk.Tx, 0, l.txs.Len())
+	for e := l.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*blobLaneTx).tx)
+	}
+	return newSliceIterator(txs)
+}
+
+// firstPFB returns the first MsgPayForBlob found among tx's messages, if any.
+func firstPFB(tx sdk.Tx) (*blobtypes.MsgPayForBlob, bool) {
+	for _, msg := range tx.GetMsgs() {
+		if pfb, ok := msg.(*blobtypes.MsgPayForBlob); ok {
+			return pfb, true
+		}
+	}
+	return nil, false
+}
+
+// feePerShare computes fee / ceil(blobSize/shareSize), pricing fee in
+// bondDenom - the same denom x/blob/ante's MinBlobFeeDecorator checks the
+// tx's minimum fee in - rather than assuming it is the fee's first (i.e.
+// alphabetically first) coin, which would silently miscompute priority for
+// a multi-denom fee or one whose fee denom doesn't sort first.
+func feePerShare(tx sdk.Tx, pfb *blobtypes.MsgPayForBlob, bondDenom string) (math.LegacyDec, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return math.LegacyZeroDec(), fmt.Errorf("blob lane: tx does not implement sdk.FeeTx")
+	}
+	fee := feeTx.GetFee()
+	amount := fee.AmountOf(bondDenom)
+	if amount.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	var totalBytes uint64
+	for _, size := range pfb.BlobSizes {
+		totalBytes += uint64(size)
+	}
+	shares := appconsts.SharesNeeded(totalBytes)
+	if shares == 0 {
+		shares = 1
+	}
+
+	return math.LegacyNewDecFromInt(amount).QuoInt64(int64(shares)), nil
+}
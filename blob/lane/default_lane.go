@@ -0,0 +1,61 @@
+package lane
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// DefaultLaneName is the registered name of the catch-all lane that admits
+// any transaction not claimed by a more specific lane.
+const DefaultLaneName = "default"
+
+// DefaultLane orders ordinary Cosmos SDK transactions by priority, breaking
+// ties by sender nonce, using the SDK's own priority-nonce mempool. It is
+// registered last so that blob and priority lanes get first refusal on a
+// transaction.
+type DefaultLane struct {
+	maxBlockSpace math.LegacyDec
+	pool          mempool.Mempool
+}
+
+// NewDefaultLane constructs a DefaultLane that may claim up to maxBlockSpace
+// of the square/byte budget during PrepareProposal.
+func NewDefaultLane(maxBlockSpace math.LegacyDec) *DefaultLane {
+	return &DefaultLane{
+		maxBlockSpace: maxBlockSpace,
+		pool:          mempool.NewPriorityMempool(),
+	}
+}
+
+func (l *DefaultLane) Name() string {
+	return DefaultLaneName
+}
+
+func (l *DefaultLane) MaxBlockSpace() math.LegacyDec {
+	return l.maxBlockSpace
+}
+
+// Match always returns true: the default lane is the catch-all registered
+// last in a LaneMempool.
+func (l *DefaultLane) Match(_ sdk.Tx) bool {
+	return true
+}
+
+func (l *DefaultLane) Insert(ctx context.Context, tx sdk.Tx) error {
+	return l.pool.Insert(ctx, tx)
+}
+
+func (l *DefaultLane) Remove(tx sdk.Tx) error {
+	return l.pool.Remove(tx)
+}
+
+func (l *DefaultLane) CountTx() int {
+	return l.pool.CountTx()
+}
+
+func (l *DefaultLane) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	return l.pool.Select(ctx, txs)
+}
@@ -0,0 +1,50 @@
+package lane
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// sliceIterator walks a pre-sorted slice of transactions. It is shared by
+// BlobLane, PriorityLane and LaneMempool.Select, since each of their Selects
+// only needs to hand back transactions in whatever order it already
+// maintains them; DefaultLane returns its SDK pool's own iterator instead.
+type sliceIterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+func newSliceIterator(txs []sdk.Tx) mempool.Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &sliceIterator{txs: txs}
+}
+
+func (i *sliceIterator) Next() mempool.Iterator {
+	if i.idx+1 >= len(i.txs) {
+		return nil
+	}
+	return &sliceIterator{txs: i.txs, idx: i.idx + 1}
+}
+
+func (i *sliceIterator) Tx() sdk.Tx {
+	return i.txs[i.idx]
+}
+
+// txHash is used as a stable map key for tracking admitted transactions
+// across Insert/Remove calls. cosmos-sdk txs do not carry a cached hash the
+// way tendermint's raw Tx does, so lanes hash the tx's own encoded bytes via
+// encoder rather than its decoded message content: two distinct txs (e.g. a
+// resubmitted MsgSend with a bumped sequence) can carry identical messages,
+// and hashing only messages would collide them.
+func txHash(tx sdk.Tx, encoder sdk.TxEncoder) (string, error) {
+	bz, err := encoder(tx)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bz)
+	return string(sum[:]), nil
+}
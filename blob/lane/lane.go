@@ -0,0 +1,58 @@
+// Package lane implements a multi-lane mempool: independently ordered
+// partitions of pending transactions, each with its own admission rule and
+// its own share of block space. celestia-app uses this to guarantee that
+// PayForBlob traffic is never starved out of a block by a flood of ordinary
+// Cosmos SDK transactions (or vice versa).
+package lane
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Lane is a self-contained partition of the mempool. It owns its own
+// ordering of the transactions it admits and is responsible for reporting
+// how much of the square/byte budget it is allowed to claim during
+// PrepareProposal.
+type Lane interface {
+	mempool.Mempool
+
+	// Name identifies the lane in logs and in PrepareProposal/ProcessProposal
+	// diagnostics. It must be unique within a LaneMempool.
+	Name() string
+
+	// Match reports whether tx belongs in this lane. LaneMempool evaluates
+	// lanes in registration order and routes tx to the first lane whose
+	// Match returns true, so more specific lanes (e.g. blob, priority) must
+	// be registered ahead of catch-all lanes (e.g. default).
+	Match(tx sdk.Tx) bool
+
+	// MaxBlockSpace is the fraction, in [0, 1], of the square/byte budget
+	// this lane may fill during PrepareProposal. Lanes are expected to sum
+	// to <= 1; LaneMempool does not enforce this. SelectUpToBytes caps each
+	// lane at its own fraction independently, so space a lane leaves unused
+	// is not passed on to later lanes.
+	MaxBlockSpace() math.LegacyDec
+}
+
+// contextKey namespaces values LaneMempool stashes on the context it passes
+// to lanes, e.g. during Select.
+type contextKey string
+
+const laneNameKey contextKey = "lane-name"
+
+// WithLaneName returns a copy of ctx annotated with the name of the lane
+// currently operating on it, for lanes that want to report which lane a
+// downstream error originated from.
+func WithLaneName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, laneNameKey, name)
+}
+
+// LaneNameFromContext returns the lane name stashed by WithLaneName, if any.
+func LaneNameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(laneNameKey).(string)
+	return name, ok
+}
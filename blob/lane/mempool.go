@@ -0,0 +1,136 @@
+package lane
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneMempool is a composite sdk.Mempool that fans transactions out to a
+// fixed, ordered set of Lanes. Insert routes a transaction to the first
+// lane whose Match returns true, so lanes must be registered from most to
+// least specific (e.g. blob, then priority, then default).
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a LaneMempool that routes transactions to lanes in
+// the given order. It panics if two lanes share a name. It does not, and
+// cannot, check that some lane would match every transaction: Match is an
+// arbitrary per-lane predicate, so "matches every transaction" isn't
+// something NewLaneMempool can decide ahead of time. Callers are
+// responsible for registering a catch-all lane last (see Lane's doc
+// comment on Match); if they don't, an unmatched transaction is not
+// silently dropped - laneFor returns an error that Insert and LaneFor both
+// propagate.
+func NewLaneMempool(lanes ...Lane) *LaneMempool {
+	seen := make(map[string]bool, len(lanes))
+	for _, l := range lanes {
+		if seen[l.Name()] {
+			panic(fmt.Sprintf("lane: duplicate lane name %q", l.Name()))
+		}
+		seen[l.Name()] = true
+	}
+	return &LaneMempool{lanes: lanes}
+}
+
+// Lanes returns the registered lanes in match order.
+func (m *LaneMempool) Lanes() []Lane {
+	return m.lanes
+}
+
+func (m *LaneMempool) laneFor(tx sdk.Tx) (Lane, error) {
+	for _, l := range m.lanes {
+		if l.Match(tx) {
+			return l, nil
+		}
+	}
+	return nil, fmt.Errorf("lane: no registered lane matched tx")
+}
+
+// LaneFor returns whichever registered lane would accept tx, i.e. the first
+// lane in registration order whose Match returns true. It is exported so
+// that ProcessProposal can re-derive, for a proposed block it did not build
+// itself, which lane's budget each of the block's transactions counts
+// against.
+func (m *LaneMempool) LaneFor(tx sdk.Tx) (Lane, error) {
+	return m.laneFor(tx)
+}
+
+func (m *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	l, err := m.laneFor(tx)
+	if err != nil {
+		return err
+	}
+	return l.Insert(WithLaneName(ctx, l.Name()), tx)
+}
+
+// Remove removes tx from whichever lane currently holds it. Lanes that do
+// not hold tx report mempool.ErrTxNotFound, which Remove treats as
+// non-fatal so callers can remove a tx without first looking up its lane.
+func (m *LaneMempool) Remove(tx sdk.Tx) error {
+	var lastErr error
+	removed := false
+	for _, l := range m.lanes {
+		if err := l.Remove(tx); err != nil {
+			if err == mempool.ErrTxNotFound {
+				lastErr = err
+				continue
+			}
+			return err
+		}
+		removed = true
+	}
+	if !removed {
+		return lastErr
+	}
+	return nil
+}
+
+func (m *LaneMempool) CountTx() int {
+	total := 0
+	for _, l := range m.lanes {
+		total += l.CountTx()
+	}
+	return total
+}
+
+// Select concatenates each lane's iterator, in lane order, with no
+// byte-budget accounting. It satisfies sdk.Mempool for callers (e.g.
+// CheckTx-triggered mempool maintenance) that just want every pending tx;
+// PrepareProposal instead calls SelectUpToBytes to respect each lane's
+// MaxBlockSpace.
+func (m *LaneMempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	var all []sdk.Tx
+	for _, l := range m.lanes {
+		for it := l.Select(ctx, txs); it != nil; it = it.Next() {
+			all = append(all, it.Tx())
+		}
+	}
+	return newSliceIterator(all)
+}
+
+// SelectUpToBytes iterates the lanes in registration order and, for each
+// one, selects transactions up to floor(lane.MaxBlockSpace * totalBytes)
+// bytes using txSize to measure each candidate. It returns the concatenated
+// selection in lane order, which PrepareProposal then hands to the
+// square-packing routine.
+func (m *LaneMempool) SelectUpToBytes(ctx context.Context, totalBytes int, txSize func(sdk.Tx) int) []sdk.Tx {
+	var selected []sdk.Tx
+	for _, l := range m.lanes {
+		budget := l.MaxBlockSpace().MulInt64(int64(totalBytes)).TruncateInt64()
+		used := int64(0)
+		for it := l.Select(ctx, nil); it != nil; it = it.Next() {
+			tx := it.Tx()
+			size := int64(txSize(tx))
+			if used+size > budget {
+				continue
+			}
+			used += size
+			selected = append(selected, tx)
+		}
+	}
+	return selected
+}
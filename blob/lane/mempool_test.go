@@ -0,0 +1,147 @@
+package lane_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+	"github.com/celestiaorg/celestia-app/blob/lane"
+	"github.com/celestiaorg/celestia-app/testutil/blobfactory"
+	"github.com/celestiaorg/celestia-app/testutil/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// wrappedTx stands in for how a BlobTx-wrapped transaction actually reaches
+// LaneMempool.Insert: an sdk.Tx carrying the raw wrapped bytes (see
+// adapter.RawTxProvider) whose own GetMsgs()/GetFee() cannot be trusted,
+// since they belong to the BlobTx envelope rather than the inner signed tx.
+type wrappedTx struct {
+	raw []byte
+}
+
+func (wrappedTx) GetMsgs() []sdk.Msg {
+	panic("wrappedTx: GetMsgs called on a still-wrapped BlobTx envelope")
+}
+
+func (wrappedTx) ValidateBasic() error {
+	panic("wrappedTx: ValidateBasic called on a still-wrapped BlobTx envelope")
+}
+
+func (t wrappedTx) Bytes() []byte {
+	return t.raw
+}
+
+// TestBlobLaneNotStarvedByDefaultLane reproduces a spammy default-lane
+// sender flooding the mempool with ordinary bank sends alongside a single
+// blob submission, and asserts that the blob lane's selection still
+// contains the PFB: the two lanes draw from disjoint budgets, so the
+// default lane's volume can never crowd the blob lane out.
+func TestBlobLaneNotStarvedByDefaultLane(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	signers := adapter.NewBlobTxSignerExtractionAdapter(encCfg.TxConfig.TxDecoder())
+
+	lm := lane.NewLaneMempool(
+		lane.NewBlobLane(math.LegacyMustNewDecFromStr("0.5"), signers, encCfg.TxConfig.TxDecoder(), "utia"),
+		lane.NewDefaultLane(math.LegacyMustNewDecFromStr("0.5")),
+	)
+
+	acc := "blob-submitter"
+	kr := testfactory.GenerateKeyring(acc)
+	signer := blobtypes.NewKeyringSigner(kr, acc, "test-chain")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	msg, _ := blobfactory.RandMsgPayForBlobWithSigner(addr.String(), 1000)
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1000000)))),
+		blobtypes.SetGasLimit(1000000),
+	}
+	builder := signer.NewTxBuilder(opts...)
+	pfbTx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+
+	require.NoError(t, lm.Insert(context.Background(), pfbTx))
+
+	spamKr := testfactory.GenerateKeyring("spammer")
+	spamSigner := blobtypes.NewKeyringSigner(spamKr, "spammer", "test-chain")
+	spamAddr, err := spamSigner.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+	spamOpts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1)))),
+		blobtypes.SetGasLimit(100000),
+	}
+	for i := 0; i < 500; i++ {
+		send := banktypes.NewMsgSend(spamAddr, testfactory.RandomAddress(), sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1))))
+		stx, err := spamSigner.BuildSignedTx(spamSigner.NewTxBuilder(spamOpts...), send)
+		require.NoError(t, err)
+		_ = lm.Insert(context.Background(), stx)
+	}
+
+	selected := lm.SelectUpToBytes(context.Background(), 1<<20, func(tx sdk.Tx) int {
+		bz, err := encCfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return len(bz)
+	})
+
+	found := false
+	for _, tx := range selected {
+		for _, m := range tx.GetMsgs() {
+			if _, ok := m.(*blobtypes.MsgPayForBlob); ok {
+				found = true
+			}
+		}
+	}
+	require.True(t, found, "blob lane's PFB must survive a default-lane flood")
+}
+
+// TestBlobLaneMatchesWrappedBlobTx inserts a genuine BlobTx-wrapped
+// transaction - one whose GetMsgs()/GetFee() cannot be trusted directly,
+// only its raw bytes via adapter.RawTxProvider - into a LaneMempool, and
+// asserts BlobLane still recognizes and admits it. This is the scenario
+// BlobLane.Match/Insert must handle for blob traffic arriving through the
+// real mempool/CheckTx path to get any starvation protection at all; a
+// naive tx.GetMsgs() on the still-wrapped form would never match.
+func TestBlobLaneMatchesWrappedBlobTx(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	signers := adapter.NewBlobTxSignerExtractionAdapter(encCfg.TxConfig.TxDecoder())
+
+	lm := lane.NewLaneMempool(
+		lane.NewBlobLane(math.LegacyMustNewDecFromStr("0.5"), signers, encCfg.TxConfig.TxDecoder(), "utia"),
+		lane.NewDefaultLane(math.LegacyMustNewDecFromStr("0.5")),
+	)
+
+	acc := "blob-submitter"
+	kr := testfactory.GenerateKeyring(acc)
+	signer := blobtypes.NewKeyringSigner(kr, acc, "test-chain")
+	addr, err := signer.GetSignerInfo().GetAddress()
+	require.NoError(t, err)
+
+	msg, blob := blobfactory.RandMsgPayForBlobWithSigner(addr.String(), 1000)
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("utia", sdk.NewInt(1000000)))),
+		blobtypes.SetGasLimit(1000000),
+	}
+	builder := signer.NewTxBuilder(opts...)
+	stx, err := signer.BuildSignedTx(builder, msg)
+	require.NoError(t, err)
+
+	rawStx, err := encCfg.TxConfig.TxEncoder()(stx)
+	require.NoError(t, err)
+	blobTxBytes, err := coretypes.MarshalBlobTx(rawStx, blob)
+	require.NoError(t, err)
+
+	require.NoError(t, lm.Insert(context.Background(), wrappedTx{raw: blobTxBytes}))
+
+	for _, l := range lm.Lanes() {
+		if l.Name() == lane.BlobLaneName {
+			require.Equal(t, 1, l.CountTx(), "wrapped BlobTx must land in the blob lane, not the default lane")
+		}
+	}
+}
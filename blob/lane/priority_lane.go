@@ -0,0 +1,153 @@
+package lane
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// PriorityLaneName is the registered name of the lane reserved for
+// high-fee-tip transactions that should jump ahead of the default lane.
+const PriorityLaneName = "priority"
+
+// priorityLaneTx is the bookkeeping PriorityLane keeps per admitted
+// transaction.
+type priorityLaneTx struct {
+	tx       sdk.Tx
+	tipRatio math.LegacyDec
+}
+
+// PriorityLane admits any transaction whose fee-per-gas tip ratio meets or
+// exceeds minTipRatio, ordering admitted transactions by that ratio
+// descending. It must be registered ahead of DefaultLane (but may be
+// registered before or after BlobLane, since the two match disjoint sets of
+// transactions in practice) so that a user willing to pay a premium is not
+// stuck behind ordinary traffic.
+type PriorityLane struct {
+	mu            sync.Mutex
+	maxBlockSpace math.LegacyDec
+	minTipRatio   math.LegacyDec
+	txEncoder     sdk.TxEncoder
+	bondDenom     string
+	txs           *list.List // sorted descending by tipRatio
+	byHash        map[string]*list.Element
+}
+
+// NewPriorityLane constructs a PriorityLane that may claim up to
+// maxBlockSpace of the square/byte budget during PrepareProposal, admitting
+// only transactions whose fee-per-gas ratio is at least minTipRatio.
+// txEncoder is used to compute a byte-identity hash for each admitted
+// transaction; bondDenom is the fee denom tipRatio prices against.
+func NewPriorityLane(maxBlockSpace, minTipRatio math.LegacyDec, txEncoder sdk.TxEncoder, bondDenom string) *PriorityLane {
+	return &PriorityLane{
+		maxBlockSpace: maxBlockSpace,
+		minTipRatio:   minTipRatio,
+		txEncoder:     txEncoder,
+		bondDenom:     bondDenom,
+		txs:           list.New(),
+		byHash:        make(map[string]*list.Element),
+	}
+}
+
+func (l *PriorityLane) Name() string {
+	return PriorityLaneName
+}
+
+func (l *PriorityLane) MaxBlockSpace() math.LegacyDec {
+	return l.maxBlockSpace
+}
+
+func (l *PriorityLane) Match(tx sdk.Tx) bool {
+	ratio, ok := tipRatio(tx, l.bondDenom)
+	return ok && ratio.GTE(l.minTipRatio)
+}
+
+func (l *PriorityLane) Insert(_ context.Context, tx sdk.Tx) error {
+	ratio, ok := tipRatio(tx, l.bondDenom)
+	if !ok {
+		ratio = math.LegacyZeroDec()
+	}
+
+	hash, err := txHash(tx, l.txEncoder)
+	if err != nil {
+		return fmt.Errorf("priority lane: hashing tx: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, exists := l.byHash[hash]; exists {
+		return nil
+	}
+
+	entry := &priorityLaneTx{tx: tx, tipRatio: ratio}
+	for e := l.txs.Front(); e != nil; e = e.Next() {
+		if entry.tipRatio.GT(e.Value.(*priorityLaneTx).tipRatio) {
+			elem := l.txs.InsertBefore(entry, e)
+			l.byHash[hash] = elem
+			return nil
+		}
+	}
+	elem := l.txs.PushBack(entry)
+	l.byHash[hash] = elem
+	return nil
+}
+
+func (l *PriorityLane) Remove(tx sdk.Tx) error {
+	hash, err := txHash(tx, l.txEncoder)
+	if err != nil {
+		return fmt.Errorf("priority lane: hashing tx: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.byHash[hash]
+	if !ok {
+		return mempool.ErrTxNotFound
+	}
+	l.txs.Remove(elem)
+	delete(l.byHash, hash)
+	return nil
+}
+
+func (l *PriorityLane) CountTx() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.txs.Len()
+}
+
+func (l *PriorityLane) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	txs := make([]sdk.Tx, 0, l.txs.Len())
+	for e := l.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*priorityLaneTx).tx)
+	}
+	return newSliceIterator(txs)
+}
+
+// tipRatio returns fee/gas, priced in bondDenom, for txs that implement both
+// sdk.FeeTx and sdk.Tx's gas accessor - how cosmos-sdk itself approximates a
+// tip rate absent a dedicated tip field. It prices against bondDenom rather
+// than assuming it is the fee's first (i.e. alphabetically first) coin, the
+// same reasoning BlobLane's feePerShare uses, since a multi-denom fee would
+// otherwise silently rank by the wrong denom's amount.
+func tipRatio(tx sdk.Tx, bondDenom string) (math.LegacyDec, bool) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return math.LegacyZeroDec(), false
+	}
+	gas := feeTx.GetGas()
+	amount := feeTx.GetFee().AmountOf(bondDenom)
+	if amount.IsZero() || gas == 0 {
+		return math.LegacyZeroDec(), false
+	}
+	return math.LegacyNewDecFromInt(amount).QuoInt64(int64(gas)), true
+}
@@ -0,0 +1,13 @@
+package appconsts
+
+// SharesNeeded returns the number of whole shares needed to hold size bytes
+// of blob data, rounding up. Blob pricing is quoted per share rather than
+// per byte - both blob/lane's fee_per_share ordering and x/blob/ante's
+// minimum-fee check need to agree on the same rounding, so both call this
+// rather than rounding inline.
+func SharesNeeded(size uint64) uint64 {
+	if size == 0 {
+		return 0
+	}
+	return (size + uint64(ShareSize) - 1) / uint64(ShareSize)
+}
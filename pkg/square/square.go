@@ -0,0 +1,35 @@
+// Package square enforces the data square's share budget over a concatenated
+// tx selection. It is the accounting half of celestia's square-packing step:
+// laying blobs out into NMT-backed rows/columns with reserved namespaces and
+// non-interactive defaults is a much larger routine that is out of scope
+// here and not part of this tree's snapshot. What this package does provide
+// - rounding each tx up to a whole number of shares and capping the total at
+// the square's share budget - is still a real, previously-missing check:
+// without it, PrepareProposal's per-lane byte budgets say nothing about
+// whether the concatenated selection even fits in one square.
+package square
+
+import (
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+)
+
+// Build admits txs, in order, up to the share budget of a maxSquareSize x
+// maxSquareSize square, rounding each tx up to a whole number of shares via
+// appconsts.SharesNeeded - the same rounding the blob lane and the
+// min-blob-fee ante decorator already bill on, so the square and the fee
+// market agree on what a transaction "costs". txs beyond the square's
+// capacity are dropped rather than returned as an error, since
+// PrepareProposal must always return some valid proposal. usedShares is the
+// number of shares admitted occupies, for callers that want to report it.
+func Build(txs [][]byte, maxSquareSize int) (admitted [][]byte, usedShares uint64) {
+	maxShares := uint64(maxSquareSize) * uint64(maxSquareSize)
+	for _, tx := range txs {
+		shares := appconsts.SharesNeeded(uint64(len(tx)))
+		if usedShares+shares > maxShares {
+			break
+		}
+		usedShares += shares
+		admitted = append(admitted, tx)
+	}
+	return admitted, usedShares
+}
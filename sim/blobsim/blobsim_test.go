@@ -0,0 +1,56 @@
+package blobsim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/sim/blobsim"
+	"github.com/celestiaorg/celestia-app/testutil/blobfactory"
+)
+
+func TestImportExport(t *testing.T) {
+	spec := blobfactory.BlobLoadSpec{
+		AccountCount:          5,
+		TxPerAccount:          4,
+		NamespaceDistribution: blobfactory.SharedNamespacePool,
+		NamespacePoolSize:     3,
+		SizeDistribution:      blobfactory.ZipfianSize,
+		MinSize:               100,
+		MaxSize:               50000,
+	}
+
+	report, err := blobsim.Run(1, spec, 4)
+	require.NoError(t, err)
+	require.True(t, report.AppHashesMatch(), "re-imported app hash must match the exported one")
+	require.Equal(t, 4, report.BlocksPlayed)
+
+	// CompareSquareRoots is a known gap (see its doc comment), not a
+	// silently-dropped one: it must fail loudly rather than report a false
+	// match.
+	require.ErrorIs(t, report.CompareSquareRoots(), blobsim.ErrSquareRootComparisonUnavailable)
+}
+
+func TestDeterministicBlobTxsIsSeedStable(t *testing.T) {
+	spec := blobfactory.BlobLoadSpec{
+		AccountCount:          3,
+		TxPerAccount:          5,
+		NamespaceDistribution: blobfactory.UniqueNamespacePerAccount,
+		SizeDistribution:      blobfactory.NormalSize,
+		MinSize:               10,
+		MaxSize:               2000,
+	}
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.TxEncoder()
+
+	first := blobfactory.DeterministicBlobTxs(enc, 42, spec)
+	second := blobfactory.DeterministicBlobTxs(enc, 42, spec)
+	require.Equal(t, len(first), len(second))
+	for i := range first {
+		require.Equal(t, first[i], second[i], "same seed must produce byte-identical txs")
+	}
+
+	third := blobfactory.DeterministicBlobTxs(enc, 43, spec)
+	require.NotEqual(t, first, third, "different seeds must not collide")
+}
@@ -0,0 +1,197 @@
+package blobfactory
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	coretypes "github.com/tendermint/tendermint/types"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// SizeDistribution selects how DeterministicBlobTxs samples each blob's byte
+// size from [MinSize, MaxSize].
+type SizeDistribution int
+
+const (
+	UniformSize SizeDistribution = iota
+	ZipfianSize
+	NormalSize
+)
+
+// NamespaceDistribution selects how DeterministicBlobTxs assigns namespaces
+// across the generated transactions.
+type NamespaceDistribution int
+
+const (
+	// UniqueNamespacePerAccount gives every account its own namespace.
+	UniqueNamespacePerAccount NamespaceDistribution = iota
+	// SharedNamespacePool draws each tx's namespace from a fixed-size pool,
+	// producing the kind of namespace collisions real usage sees.
+	SharedNamespacePool
+)
+
+// BlobLoadSpec describes the population of BlobTxs DeterministicBlobTxs
+// should generate.
+type BlobLoadSpec struct {
+	AccountCount int
+	TxPerAccount int
+	NonceStart   uint64
+
+	NamespaceDistribution NamespaceDistribution
+	NamespacePoolSize     int // only used by SharedNamespacePool
+
+	SizeDistribution SizeDistribution
+	MinSize          int
+	MaxSize          int
+}
+
+// DeterministicBlobTxs produces a byte-identical stream of BlobTxs across
+// runs for a given (seed, spec) pair. It replaces the tmrand/math/rand
+// global sources RandBlobTxsRandomlySized relies on, both for blob contents
+// and for account key material, so that a sim/blobsim replay of the same
+// seed always builds the exact same square.
+func DeterministicBlobTxs(enc sdk.TxEncoder, seed int64, spec BlobLoadSpec) []coretypes.Tx {
+	rng := rand.New(rand.NewSource(seed))
+
+	var namespacePool [][]byte
+	if spec.NamespaceDistribution == SharedNamespacePool {
+		poolSize := spec.NamespacePoolSize
+		if poolSize == 0 {
+			poolSize = 8
+		}
+		for i := 0; i < poolSize; i++ {
+			namespacePool = append(namespacePool, deterministicNamespace(rng))
+		}
+	}
+
+	var txs []coretypes.Tx
+	for a := 0; a < spec.AccountCount; a++ {
+		signer := deterministicSigner(seed, a)
+		addr, err := signer.GetSignerInfo().GetAddress()
+		if err != nil {
+			panic(err)
+		}
+
+		ns := deterministicNamespace(rng)
+
+		for n := 0; n < spec.TxPerAccount; n++ {
+			if spec.NamespaceDistribution == SharedNamespacePool {
+				ns = namespacePool[rng.Intn(len(namespacePool))]
+			}
+
+			size := sampleSize(rng, spec)
+			data := make([]byte, size)
+			rng.Read(data)
+
+			b, err := types.NewBlob(ns, data)
+			if err != nil {
+				panic(err)
+			}
+			msg, err := blobtypes.NewMsgPayForBlob(addr.String(), b)
+			if err != nil {
+				panic(err)
+			}
+
+			opts := []blobtypes.TxBuilderOption{
+				blobtypes.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(10)))),
+				blobtypes.SetGasLimit(10000000),
+				blobtypes.SetSequence(spec.NonceStart + uint64(n)),
+			}
+			builder := signer.NewTxBuilder(opts...)
+			stx, err := signer.BuildSignedTx(builder, msg)
+			if err != nil {
+				panic(err)
+			}
+			rawTx, err := enc(stx)
+			if err != nil {
+				panic(err)
+			}
+			cTx, err := coretypes.MarshalBlobTx(rawTx, b)
+			if err != nil {
+				panic(err)
+			}
+			txs = append(txs, cTx)
+		}
+	}
+	return txs
+}
+
+// DeterministicAccounts returns the AccountCount addresses DeterministicBlobTxs
+// would derive for (seed, spec), without generating any transactions.
+// Callers that need to fund these accounts ahead of time (e.g. sim/blobsim's
+// genesis setup) can't derive them any other way, since deterministicSigner's
+// key material never leaves this package.
+func DeterministicAccounts(seed int64, spec BlobLoadSpec) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, spec.AccountCount)
+	for a := 0; a < spec.AccountCount; a++ {
+		signer := deterministicSigner(seed, a)
+		addr, err := signer.GetSignerInfo().GetAddress()
+		if err != nil {
+			panic(err)
+		}
+		addrs[a] = addr
+	}
+	return addrs
+}
+
+// sampleSize draws a blob size from spec's distribution over
+// [spec.MinSize, spec.MaxSize], using rng so callers get an identical
+// sequence of sizes for a given seed.
+func sampleSize(rng *rand.Rand, spec BlobLoadSpec) int {
+	span := spec.MaxSize - spec.MinSize
+	if span <= 0 {
+		return spec.MinSize
+	}
+	switch spec.SizeDistribution {
+	case ZipfianSize:
+		z := rand.NewZipf(rng, 1.5, 1, uint64(span))
+		return spec.MinSize + int(z.Uint64())
+	case NormalSize:
+		mean := float64(span) / 2
+		stddev := float64(span) / 6
+		v := rng.NormFloat64()*stddev + mean
+		if v < 0 {
+			v = 0
+		}
+		if v > float64(span) {
+			v = float64(span)
+		}
+		return spec.MinSize + int(v)
+	default:
+		return spec.MinSize + rng.Intn(span+1)
+	}
+}
+
+func deterministicNamespace(rng *rand.Rand) []byte {
+	ns := make([]byte, 8)
+	if _, err := rng.Read(ns); err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+// deterministicSigner derives a KeyringSigner whose private key is a pure
+// function of (seed, idx): the same pair always yields the same address,
+// independent of wall-clock time or any other process-global randomness.
+func deterministicSigner(seed int64, idx int) *blobtypes.KeyringSigner {
+	secret := sha256.Sum256([]byte(fmt.Sprintf("blobsim-seed-%d-account-%d", seed, idx)))
+	mnemonic, err := bip39.NewMnemonic(secret[:])
+	if err != nil {
+		panic(err)
+	}
+
+	acc := fmt.Sprintf("acc-%d", idx)
+	kr := keyring.NewInMemory()
+	if _, err := kr.NewAccount(acc, mnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1); err != nil {
+		panic(err)
+	}
+
+	return blobtypes.NewKeyringSigner(kr, acc, "blobsim-chain")
+}
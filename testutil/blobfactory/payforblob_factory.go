@@ -2,6 +2,7 @@ package blobfactory
 
 import (
 	"context"
+	mathrand "math/rand"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/testutil/namespace"
@@ -182,6 +183,75 @@ func RandBlobTxsWithAccounts(
 	return txs
 }
 
+// RandBlobTxsWithAccountsAndSeed behaves exactly like RandBlobTxsWithAccounts
+// except it draws each tx's randomized size from a rand.Rand seeded with
+// seed, instead of tendermint's libs/rand global source, so repeated runs
+// against the same accounts produce the same sequence of sizes. It can't
+// reuse DeterministicBlobTxs for this: that helper brings its own keyring and
+// chain ID, whereas this one signs with accounts the caller's network has
+// already funded.
+func RandBlobTxsWithAccountsAndSeed(
+	enc sdk.TxEncoder,
+	kr keyring.Keyring,
+	conn *grpc.ClientConn,
+	size int,
+	randSize bool,
+	chainid string,
+	accounts []string,
+	seed int64,
+) []coretypes.Tx {
+	rng := mathrand.New(mathrand.NewSource(seed))
+
+	coin := sdk.Coin{
+		Denom:  bondDenom,
+		Amount: sdk.NewInt(10),
+	}
+
+	opts := []blobtypes.TxBuilderOption{
+		blobtypes.SetFeeAmount(sdk.NewCoins(coin)),
+		blobtypes.SetGasLimit(100000000000000),
+	}
+
+	txs := make([]coretypes.Tx, len(accounts))
+	for i := 0; i < len(accounts); i++ {
+		signer := blobtypes.NewKeyringSigner(kr, accounts[i], chainid)
+		err := signer.QueryAccountNumber(context.Background(), conn)
+		if err != nil {
+			panic(err)
+		}
+
+		addr, err := signer.GetSignerInfo().GetAddress()
+		if err != nil {
+			panic(err)
+		}
+
+		randomizedSize := size
+		if randSize {
+			randomizedSize = rng.Intn(size)
+			if randomizedSize == 0 {
+				randomizedSize = 1
+			}
+		}
+		msg, blob := RandMsgPayForBlobWithSigner(addr.String(), randomizedSize)
+		builder := signer.NewTxBuilder(opts...)
+		stx, err := signer.BuildSignedTx(builder, msg)
+		if err != nil {
+			panic(err)
+		}
+		rawTx, err := enc(stx)
+		if err != nil {
+			panic(err)
+		}
+		cTx, err := coretypes.MarshalBlobTx(rawTx, blob)
+		if err != nil {
+			panic(err)
+		}
+		txs[i] = cTx
+	}
+
+	return txs
+}
+
 func RandBlobTxs(enc sdk.TxEncoder, count, size int) []coretypes.Tx {
 	const acc = "signer"
 	kr := testfactory.GenerateKeyring(acc)
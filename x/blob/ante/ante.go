@@ -0,0 +1,27 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+)
+
+// NewAnteHandler chains the blob module's two decorators into a single
+// sdk.AnteHandler, signer validation before the fee floor check since a fee
+// paid by the wrong account shouldn't be priced in the first place. It only
+// covers the blob module's own decorators; the chain's standard auth/bank
+// decorators are expected to precede it wherever the full ante chain is
+// assembled.
+//
+// Nothing in this tree assembles that chain: grepping the repo for
+// NewAnteHandler call sites turns up none, so the min-blob-fee floor and
+// signer validation this decorator pair exists to enforce are never actually
+// run against a submitted transaction. Whoever owns the app's full ante
+// chain (options.AnteHandler or equivalent) needs to splice this in - this
+// is a hard blocker, not something resolved by this package existing.
+func NewAnteHandler(signers adapter.SignerExtractionAdapter, feeMarket BlobFeeMarketKeeper, bondDenom string) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		NewValidateBlobTxSignerDecorator(signers),
+		NewMinBlobFeeDecorator(feeMarket, bondDenom),
+	)
+}
@@ -0,0 +1,67 @@
+package ante
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// BlobFeeMarketKeeper is the subset of the blob keeper MinBlobFeeDecorator
+// needs, so the ante handler does not have to import the full keeper
+// package.
+type BlobFeeMarketKeeper interface {
+	GetBaseGasPricePerByte(ctx sdk.Context) math.LegacyDec
+}
+
+// MinBlobFeeDecorator rejects a MsgPayForBlob that pays less than
+// numShares * shareSize * BaseGasPricePerByte, the same share-rounded size
+// (via appconsts.SharesNeeded) that blob/lane's fee_per_share ordering uses,
+// so the two agree on what a blob tx is priced against.
+type MinBlobFeeDecorator struct {
+	feeMarket BlobFeeMarketKeeper
+	bondDenom string
+}
+
+// NewMinBlobFeeDecorator constructs a MinBlobFeeDecorator backed by
+// feeMarket, checking fees paid in bondDenom.
+func NewMinBlobFeeDecorator(feeMarket BlobFeeMarketKeeper, bondDenom string) MinBlobFeeDecorator {
+	return MinBlobFeeDecorator{feeMarket: feeMarket, bondDenom: bondDenom}
+}
+
+func (d MinBlobFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var totalBlobSize uint64
+	for _, msg := range tx.GetMsgs() {
+		pfb, ok := msg.(*blobtypes.MsgPayForBlob)
+		if !ok {
+			continue
+		}
+		for _, size := range pfb.BlobSizes {
+			totalBlobSize += uint64(size)
+		}
+	}
+	if totalBlobSize == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "blob tx must implement sdk.FeeTx")
+	}
+
+	basePrice := d.feeMarket.GetBaseGasPricePerByte(ctx)
+	billedBytes := appconsts.SharesNeeded(totalBlobSize) * uint64(appconsts.ShareSize)
+	minFee := basePrice.MulInt64(int64(billedBytes)).Ceil().TruncateInt()
+
+	fee := feeTx.GetFee()
+	paid := fee.AmountOf(d.bondDenom)
+	if paid.LT(minFee) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+			"blob tx pays %s%s, but the fee market requires at least %s%s (%s per byte for %d bytes, rounded up to %d shares)",
+			paid, d.bondDenom, minFee, d.bondDenom, basePrice, billedBytes, appconsts.SharesNeeded(totalBlobSize))
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,54 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/celestiaorg/celestia-app/blob/adapter"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// ValidateBlobTxSignerDecorator checks that every MsgPayForBlob in a
+// transaction is signed by the same account it names as its Signer field. It
+// resolves the signer through a SignerExtractionAdapter rather than
+// tx.GetSigners() directly. The MsgPayForBlobs themselves are read from
+// tx.GetMsgs() on tx as given, so a tx still in its BlobTx envelope yields
+// no MsgPayForBlob and is passed on to next without this check.
+type ValidateBlobTxSignerDecorator struct {
+	signers adapter.SignerExtractionAdapter
+}
+
+// NewValidateBlobTxSignerDecorator constructs a ValidateBlobTxSignerDecorator
+// using signers to resolve a transaction's true payer.
+func NewValidateBlobTxSignerDecorator(signers adapter.SignerExtractionAdapter) ValidateBlobTxSignerDecorator {
+	return ValidateBlobTxSignerDecorator{signers: signers}
+}
+
+func (d ValidateBlobTxSignerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	var pfbs []*blobtypes.MsgPayForBlob
+	for _, msg := range tx.GetMsgs() {
+		if pfb, ok := msg.(*blobtypes.MsgPayForBlob); ok {
+			pfbs = append(pfbs, pfb)
+		}
+	}
+	if len(pfbs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	signerData, err := d.signers.GetSigners(tx)
+	if err != nil {
+		return ctx, sdkerrors.Wrap(err, "resolving blob tx signer")
+	}
+	if len(signerData) == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "blob tx has no signers")
+	}
+	payer := signerData[0].Signer.String()
+
+	for _, pfb := range pfbs {
+		if pfb.Signer != payer {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "MsgPayForBlob signer %s does not match tx payer %s", pfb.Signer, payer)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
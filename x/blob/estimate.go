@@ -0,0 +1,45 @@
+package blob
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// EstimateGasForBlob returns the fee, in the bond denom's base unit, a
+// wallet should attach to a PayForBlob carrying blob in order to pay
+// basePrice per byte plus the given tip rate on top. tipRate is a fraction
+// (e.g. 0.1 for a 10% tip) added on top of the fee-market floor to jump the
+// blob lane's fee_per_share ordering; pass zero to pay exactly the floor.
+//
+// The floor is billed on numShares * ShareSize, not len(blob.Data) directly
+// - the same share-rounded size (via appconsts.SharesNeeded) that
+// x/blob/ante's MinBlobFeeDecorator actually enforces - so a blob whose size
+// isn't an exact multiple of ShareSize isn't under-estimated here and then
+// rejected with ErrInsufficientFee at broadcast.
+func EstimateGasForBlob(blob *blobtypes.Blob, basePrice, tipRate math.LegacyDec) math.Int {
+	billedBytes := appconsts.SharesNeeded(uint64(len(blob.Data))) * uint64(appconsts.ShareSize)
+	floor := basePrice.MulInt64(int64(billedBytes))
+	return floor.Mul(math.LegacyOneDec().Add(tipRate)).Ceil().TruncateInt()
+}
+
+// QueryBaseGasPrice fetches the blob module's current BaseGasPricePerByte
+// over conn, so a wallet can quote a fee before building a PayForBlob
+// transaction and calling EstimateGasForBlob. It only works once BaseGasPrice
+// is added to query.proto, regenerated, and registered on the module's gRPC
+// Query service - none of which exists yet, so conn.Invoke below has nothing
+// to dial. Until then, callers should read Keeper.GetBaseGasPricePerByte
+// directly; once the service is registered, switch to the generated
+// QueryClient instead of this hand-rolled Invoke.
+func QueryBaseGasPrice(ctx context.Context, conn *grpc.ClientConn) (math.LegacyDec, error) {
+	req := &blobtypes.QueryBaseGasPriceRequest{}
+	resp := &blobtypes.QueryBaseGasPriceResponse{}
+	if err := conn.Invoke(ctx, "/celestia.blob.v1.Query/BaseGasPrice", req, resp); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return resp.BaseGasPricePerByte, nil
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// Store key prefixes for the blob fee market. These live alongside (not
+// inside) the module's legacy x/params-backed Params, since the fee market
+// tracks a moving price that is updated every block rather than governed
+// by governance-only parameter changes.
+var (
+	KeyBaseGasPricePerByte = []byte{0x50}
+	KeyUsedSharesCursor    = []byte{0x51}
+	// KeyUsedSharesWindowPrefix is followed by a big-endian uint64 window
+	// slot index to form the full key for that slot's recorded share count.
+	KeyUsedSharesWindowPrefix = []byte{0x52}
+)
+
+// FeeMarketParams returns the current fee market parameters. It is backed by
+// the module's existing param store the same way the rest of the module's
+// Params are, via k.paramSpace. x/params' Subspace.GetParamSet panics on a
+// key that was never set via SetParamSet, so FeeMarketParams checks for that
+// first and falls back to types.DefaultFeeMarketParams() - a chain whose
+// genesis/migration path never called SetFeeMarketParams still gets a
+// working fee market instead of panicking the first time any of
+// GetBaseGasPricePerByte, EndBlocker, or the min-blob-fee ante decorator
+// runs.
+func (k Keeper) FeeMarketParams(ctx sdk.Context) types.FeeMarketParams {
+	if !k.paramSpace.Has(ctx, types.KeyTargetSquareShares) {
+		return types.DefaultFeeMarketParams()
+	}
+	var params types.FeeMarketParams
+	k.paramSpace.GetParamSet(ctx, &feeMarketParamsAlias{params: &params})
+	return params
+}
+
+// SetFeeMarketParams sets the fee market parameters. It is expected to be
+// called from InitGenesis, the v2 store migration (MigrateStore in
+// x/blob/migrations/v2) and governance param-change proposals.
+func (k Keeper) SetFeeMarketParams(ctx sdk.Context, params types.FeeMarketParams) {
+	k.paramSpace.SetParamSet(ctx, &feeMarketParamsAlias{params: &params})
+}
+
+// GetBaseGasPricePerByte returns the blob module's current per-byte gas
+// price. Ante handlers use this to reject underpaying PayForBlob txs; the
+// EndBlocker updates it once per block from observed demand.
+func (k Keeper) GetBaseGasPricePerByte(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(KeyBaseGasPricePerByte)
+	if bz == nil {
+		return k.FeeMarketParams(ctx).MinBaseGasPricePerByte
+	}
+	price := math.LegacyDec{}
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price
+}
+
+func (k Keeper) setBaseGasPricePerByte(ctx sdk.Context, price math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(KeyBaseGasPricePerByte, bz)
+}
+
+// RecordUsedShares stashes the number of shares the current block's square
+// consumed into the fee market's sliding window. It must be called exactly
+// once per block, after the square for that block has been built (e.g. from
+// PrepareProposal/ProcessProposal), and before EndBlocker runs.
+func (k Keeper) RecordUsedShares(ctx sdk.Context, usedShares uint64) {
+	params := k.FeeMarketParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	cursor := k.usedSharesCursor(ctx)
+	store.Set(windowSlotKey(cursor%params.WindowSize), sdk.Uint64ToBigEndian(usedShares))
+	store.Set(KeyUsedSharesCursor, sdk.Uint64ToBigEndian(cursor+1))
+}
+
+func (k Keeper) usedSharesCursor(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(KeyUsedSharesCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func windowSlotKey(slot uint64) []byte {
+	key := make([]byte, len(KeyUsedSharesWindowPrefix)+8)
+	copy(key, KeyUsedSharesWindowPrefix)
+	binary.BigEndian.PutUint64(key[len(KeyUsedSharesWindowPrefix):], slot)
+	return key
+}
+
+// averageUsedShares returns the mean of every recorded window slot, treating
+// slots that have never been written (e.g. during the first WindowSize
+// blocks of a chain's life) as zero usage.
+func (k Keeper) averageUsedShares(ctx sdk.Context, windowSize uint64) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+
+	var sum uint64
+	for slot := uint64(0); slot < windowSize; slot++ {
+		bz := store.Get(windowSlotKey(slot))
+		if bz == nil {
+			continue
+		}
+		sum += sdk.BigEndianToUint64(bz)
+	}
+	return math.LegacyNewDec(int64(sum)).QuoInt64(int64(windowSize))
+}
+
+// EndBlocker updates BaseGasPricePerByte from the fee market's sliding
+// window of recent demand:
+//
+//	newPrice = oldPrice * (1 + MaxAdjustmentPerBlock*(avgUsed-target)/target)
+//
+// clamped below by MinBaseGasPricePerByte.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.FeeMarketParams(ctx)
+
+	avgUsed := k.averageUsedShares(ctx, params.WindowSize)
+	target := math.LegacyNewDec(int64(params.TargetSquareShares))
+
+	demandError := avgUsed.Sub(target).Quo(target)
+	adjustment := math.LegacyOneDec().Add(params.MaxAdjustmentPerBlock.Mul(demandError))
+
+	newPrice := k.GetBaseGasPricePerByte(ctx).Mul(adjustment)
+	if newPrice.LT(params.MinBaseGasPricePerByte) {
+		newPrice = params.MinBaseGasPricePerByte
+	}
+
+	k.setBaseGasPricePerByte(ctx, newPrice)
+}
+
+// feeMarketParamsAlias adapts types.FeeMarketParams to the legacy
+// params.ParamSet interface expected by x/params' Subspace, without
+// requiring FeeMarketParams itself (a plain value type used outside of
+// governance-gated param storage) to implement it.
+type feeMarketParamsAlias struct {
+	params *types.FeeMarketParams
+}
+
+func (a *feeMarketParamsAlias) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(types.KeyTargetSquareShares, &a.params.TargetSquareShares, validateTargetSquareShares),
+		paramtypes.NewParamSetPair(types.KeyWindowSize, &a.params.WindowSize, validateWindowSize),
+		paramtypes.NewParamSetPair(types.KeyMaxAdjustmentPerBlock, &a.params.MaxAdjustmentPerBlock, validateFeeMarketDec),
+		paramtypes.NewParamSetPair(types.KeyMinBaseGasPricePerByte, &a.params.MinBaseGasPricePerByte, validateFeeMarketDec),
+	}
+}
+
+func validateTargetSquareShares(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok || v == 0 {
+		return errInvalidFeeMarketParam("TargetSquareShares")
+	}
+	return nil
+}
+
+func validateWindowSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok || v == 0 {
+		return errInvalidFeeMarketParam("WindowSize")
+	}
+	return nil
+}
+
+func validateFeeMarketDec(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok || v.IsNil() || v.IsNegative() {
+		return errInvalidFeeMarketParam("fee market dec param")
+	}
+	return nil
+}
+
+func errInvalidFeeMarketParam(name string) error {
+	return sdkerrors.ErrInvalidRequest.Wrapf("invalid %s", name)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/celestiaorg/celestia-app/testutil/keeper"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// TestFeeMarketRisesUnderSustainedDemandAndDecaysWhenEmpty exercises the
+// EndBlocker's price update in isolation from the rest of the module: it
+// seeds the fee market's sliding window with WindowSize blocks at or above
+// TargetSquareShares and asserts BaseGasPricePerByte only goes up, then
+// seeds it with empty blocks and asserts it falls back towards the floor.
+//
+// TestFeeMarketRisesAndDecaysAgainstRealApp in app/test covers the same
+// rise/decay against an in-process app with real PayForBlob transactions.
+func TestFeeMarketRisesUnderSustainedDemandAndDecaysWhenEmpty(t *testing.T) {
+	k, ctx := keepertest.BlobKeeper(t)
+
+	params := types.DefaultFeeMarketParams()
+	k.SetFeeMarketParams(ctx, params)
+
+	startPrice := k.GetBaseGasPricePerByte(ctx)
+	require.True(t, startPrice.Equal(params.MinBaseGasPricePerByte))
+
+	// Sustain demand at double the target for a full window; price should
+	// only ever move upward block over block.
+	last := startPrice
+	for i := uint64(0); i < params.WindowSize; i++ {
+		k.RecordUsedShares(ctx, params.TargetSquareShares*2)
+		k.EndBlocker(ctx)
+		next := k.GetBaseGasPricePerByte(ctx)
+		require.True(t, next.GTE(last), "price must not fall under sustained demand")
+		last = next
+	}
+	require.True(t, last.GT(startPrice), "price must have risen from sustained demand")
+
+	// Now sustain empty blocks for a full window; price should fall back
+	// towards (but never below) the floor. The window still averages above
+	// target until at least half of its slots hold empty blocks, so until
+	// then the price keeps rising.
+	risenPrice := last
+	for i := uint64(0); i < params.WindowSize; i++ {
+		k.RecordUsedShares(ctx, 0)
+		k.EndBlocker(ctx)
+		next := k.GetBaseGasPricePerByte(ctx)
+		if 2*(i+1) >= params.WindowSize {
+			require.True(t, next.LTE(last), "price must not rise once the window averages at or below target")
+		} else {
+			require.True(t, next.GTE(last), "price must keep rising while the window averages above target")
+		}
+		require.True(t, next.GTE(params.MinBaseGasPricePerByte), "price must never fall below the floor")
+		last = next
+	}
+	require.True(t, last.LT(risenPrice), "price must have decayed from empty blocks")
+}
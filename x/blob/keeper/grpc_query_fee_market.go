@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// BaseGasPrice implements the blob module's BaseGasPrice query, returning
+// the current fee-market floor. It is not registered on the module's gRPC
+// Query service; see QueryBaseGasPrice in x/blob/estimate.go.
+func (k Keeper) BaseGasPrice(c context.Context, _ *types.QueryBaseGasPriceRequest) (*types.QueryBaseGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBaseGasPriceResponse{
+		BaseGasPricePerByte: k.GetBaseGasPricePerByte(ctx),
+	}, nil
+}
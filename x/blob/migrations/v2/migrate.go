@@ -0,0 +1,35 @@
+// Package v2 migrates the blob module's state from its genesis layout to
+// add the EIP-1559-style fee market introduced alongside BaseGasPricePerByte.
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+)
+
+// FeeMarketKeeper is the subset of the blob keeper this migration needs.
+type FeeMarketKeeper interface {
+	SetFeeMarketParams(ctx sdk.Context, params types.FeeMarketParams)
+}
+
+// MigrateStore seeds the fee market's parameters with their defaults. The
+// sliding-window usage history is intentionally left empty: EndBlocker
+// treats unrecorded window slots as zero usage, so the fee market starts at
+// MinBaseGasPricePerByte and climbs only once real demand is observed.
+//
+// No module in this tree calls MigrateStore from a RegisterMigrations
+// callback, and nothing bumps the blob module's ConsensusVersion to the one
+// this migration targets - so on an upgrading chain this function never
+// runs. Keeper.FeeMarketParams falls back to types.DefaultFeeMarketParams()
+// when it detects the params were never set, so an unmigrated chain gets a
+// working (if ungoverned) fee market rather than a panic - but the params
+// this migration is meant to actually persist to the param store, so that a
+// later governance param-change proposal has something to diff against, are
+// still never written. Wiring MigrateStore into RegisterMigrations is a hard
+// blocker for whoever owns the blob module's module.go, not something this
+// package can do on its own.
+func MigrateStore(ctx sdk.Context, k FeeMarketKeeper) error {
+	k.SetFeeMarketParams(ctx, types.DefaultFeeMarketParams())
+	return nil
+}
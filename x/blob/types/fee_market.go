@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+)
+
+// FeeMarketParams governs the EIP-1559-style fee market that adjusts
+// BaseGasPricePerByte every block based on recent blob demand.
+type FeeMarketParams struct {
+	// TargetSquareShares is the number of shares per block the fee market
+	// steers demand towards. Sustained usage above this raises
+	// BaseGasPricePerByte; sustained usage below it lowers it.
+	TargetSquareShares uint64
+	// WindowSize is the number of trailing blocks averaged to smooth out
+	// single-block demand spikes before adjusting price.
+	WindowSize uint64
+	// MaxAdjustmentPerBlock scales how far BaseGasPricePerByte moves in a
+	// single block: the price changes by this fraction of its current value
+	// times (avgUsed-target)/target. That relative gap is not clamped, so the
+	// move stays within this fraction only while average usage is at most
+	// twice TargetSquareShares.
+	MaxAdjustmentPerBlock math.LegacyDec
+	// MinBaseGasPricePerByte is the floor BaseGasPricePerByte may never drop
+	// below, regardless of how empty recent blocks have been.
+	MinBaseGasPricePerByte math.LegacyDec
+}
+
+// DefaultWindowSize is the number of blocks the fee market averages blob
+// demand over before adjusting BaseGasPricePerByte.
+const DefaultWindowSize = 100
+
+// DefaultMaxAdjustmentPerBlockStr and DefaultMinBaseGasPricePerByteStr are
+// kept as strings, as is conventional for cosmos-sdk Dec defaults, so they
+// are parsed once at init rather than constructed inline everywhere.
+const (
+	DefaultMaxAdjustmentPerBlockStr  = "0.125"
+	DefaultMinBaseGasPricePerByteStr = "0.000001"
+)
+
+// DefaultTargetSquareShares is half the shares available in a block at the
+// default max square size, as suggested by the fee-market design doc.
+func DefaultTargetSquareShares() uint64 {
+	return uint64(appconsts.DefaultMaxSquareSize) * uint64(appconsts.DefaultMaxSquareSize) / 2
+}
+
+// DefaultFeeMarketParams returns the fee market parameters celestia-app
+// ships with at genesis.
+func DefaultFeeMarketParams() FeeMarketParams {
+	return FeeMarketParams{
+		TargetSquareShares:     DefaultTargetSquareShares(),
+		WindowSize:             DefaultWindowSize,
+		MaxAdjustmentPerBlock:  math.LegacyMustNewDecFromStr(DefaultMaxAdjustmentPerBlockStr),
+		MinBaseGasPricePerByte: math.LegacyMustNewDecFromStr(DefaultMinBaseGasPricePerByteStr),
+	}
+}
+
+// Validate returns an error if the fee market parameters are nonsensical.
+func (p FeeMarketParams) Validate() error {
+	if p.TargetSquareShares == 0 {
+		return fmt.Errorf("blob: TargetSquareShares must be positive")
+	}
+	if p.WindowSize == 0 {
+		return fmt.Errorf("blob: WindowSize must be positive")
+	}
+	if p.MaxAdjustmentPerBlock.IsNil() || p.MaxAdjustmentPerBlock.IsNegative() {
+		return fmt.Errorf("blob: MaxAdjustmentPerBlock must be non-negative")
+	}
+	if p.MinBaseGasPricePerByte.IsNil() || !p.MinBaseGasPricePerByte.IsPositive() {
+		return fmt.Errorf("blob: MinBaseGasPricePerByte must be positive")
+	}
+	return nil
+}
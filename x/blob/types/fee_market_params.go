@@ -0,0 +1,11 @@
+package types
+
+// Parameter store keys for the blob fee market. These are separate from the
+// module's existing parameter keys since the fee market params were added
+// in a later upgrade (see x/blob/migrations/v2).
+var (
+	KeyTargetSquareShares     = []byte("TargetSquareShares")
+	KeyWindowSize             = []byte("WindowSize")
+	KeyMaxAdjustmentPerBlock  = []byte("MaxAdjustmentPerBlock")
+	KeyMinBaseGasPricePerByte = []byte("MinBaseGasPricePerByte")
+)
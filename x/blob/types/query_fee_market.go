@@ -0,0 +1,27 @@
+package types
+
+import "cosmossdk.io/math"
+
+// QueryBaseGasPriceRequest and QueryBaseGasPriceResponse back the blob
+// module's BaseGasPrice query, which lets a wallet quote a PayForBlob fee
+// before submitting it. These are ordinarily protoc-generated from
+// query.proto alongside the module's other Query messages; until BaseGasPrice
+// is added to the .proto file and regenerated, they're hand-written here with
+// just enough of proto.Message to compile against. Registering them on an
+// actual gRPC Query service still requires that regeneration - see
+// QueryBaseGasPrice in estimate.go.
+type QueryBaseGasPriceRequest struct{}
+
+func (*QueryBaseGasPriceRequest) Reset()         {}
+func (*QueryBaseGasPriceRequest) String() string { return "QueryBaseGasPriceRequest{}" }
+func (*QueryBaseGasPriceRequest) ProtoMessage()  {}
+
+type QueryBaseGasPriceResponse struct {
+	BaseGasPricePerByte math.LegacyDec
+}
+
+func (r *QueryBaseGasPriceResponse) Reset() { *r = QueryBaseGasPriceResponse{} }
+func (r *QueryBaseGasPriceResponse) String() string {
+	return "QueryBaseGasPriceResponse{" + r.BaseGasPricePerByte.String() + "}"
+}
+func (*QueryBaseGasPriceResponse) ProtoMessage() {}